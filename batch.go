@@ -0,0 +1,91 @@
+package uuid
+
+import "encoding/binary"
+
+// v1BatchMax is the largest batch NewV1Batch will generate in one call, so
+// that a single call never advances the clock by more ticks (each 100ns)
+// than fit comfortably within one generation round-trip.
+const v1BatchMax = 10000
+
+// NewV1Batch reserves a contiguous run of n time-based UUIDs in a single
+// critical section: one tick() advances the clock state, each entry's
+// Gregorian timestamp is then its own index added on top of that tick (so
+// every UUID in the batch gets a distinct, strictly increasing timestamp
+// rather than all sharing one), and the Saver (if any) is invoked once at
+// the end with the final Store, instead of once per UUID as a loop of
+// NewV1 would. n is capped at v1BatchMax.
+func (g *Generator) NewV1Batch(n int) []UUID {
+	if n <= 0 {
+		return nil
+	}
+	if n > v1BatchMax {
+		n = v1BatchMax
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	g.tick()
+	if g.err != nil {
+		return nil
+	}
+
+	ts := uint64(g.Timestamp)
+	seq := uint16(g.Sequence)
+	node := fitNode(g.Node)
+
+	out := make([]UUID, n)
+	for i := 0; i < n; i++ {
+		entryTS := ts + uint64(i)
+
+		u := make(UUID, 16)
+		binary.BigEndian.PutUint32(u[0:4], uint32(entryTS))
+		binary.BigEndian.PutUint16(u[4:6], uint16(entryTS>>32))
+		binary.BigEndian.PutUint16(u[6:8], uint16(entryTS>>48)&0x0FFF)
+		u[6] |= uint8(One) << 4
+		binary.BigEndian.PutUint16(u[8:10], seq)
+		u[8] &= 0x3F
+		u[8] |= 0x80
+		copy(u[10:16], node)
+		out[i] = u
+	}
+
+	g.Timestamp = Timestamp(ts + uint64(n))
+	g.save()
+
+	return out
+}
+
+// NewV4Batch fills n random UUIDs from a single Random call sized 16*n,
+// instead of the n separate calls a loop of NewV4 would make.
+func (g *Generator) NewV4Batch(n int) []UUID {
+	if n <= 0 {
+		return nil
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	buf := make([]byte, 16*n)
+	r, err := g.Random(buf)
+	if err != nil || r != len(buf) {
+		g.err = err
+		return nil
+	}
+
+	out := make([]UUID, n)
+	for i := 0; i < n; i++ {
+		u := UUID(buf[i*16 : (i+1)*16])
+		u[6] = (u[6] & 0x0F) | (uint8(Four) << 4)
+		u[8] = (u[8] & 0x3F) | 0x80
+		out[i] = u
+	}
+
+	return out
+}
+
+// NewV1Batch generates n version 1 UUIDs using the default Generator.
+func NewV1Batch(n int) []UUID { return generator.NewV1Batch(n) }
+
+// NewV4Batch generates n version 4 UUIDs using the default Generator.
+func NewV4Batch(n int) []UUID { return generator.NewV4Batch(n) }
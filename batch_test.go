@@ -0,0 +1,90 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// v1Timestamp reassembles the 60-bit Gregorian timestamp packed into a v1
+// UUID's time_low/time_mid/time_hi_and_version fields.
+func v1Timestamp(u UUID) uint64 {
+	low := uint64(binary.BigEndian.Uint32(u[0:4]))
+	mid := uint64(binary.BigEndian.Uint16(u[4:6]))
+	hi := uint64(binary.BigEndian.Uint16(u[6:8]) & 0x0FFF)
+	return hi<<48 | mid<<32 | low
+}
+
+func TestGenerator_NewV1Batch(t *testing.T) {
+	out := generator.NewV1Batch(16)
+
+	assert.Len(t, out, 16)
+
+	seen := map[string]bool{}
+	for _, u := range out {
+		assert.Equal(t, One, u.Version(), "Expected correct version")
+		assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+		assert.False(t, seen[u.String()], "Batch should not contain duplicates")
+		seen[u.String()] = true
+	}
+}
+
+func TestGenerator_NewV1Batch_CapsAtMax(t *testing.T) {
+	out := generator.NewV1Batch(v1BatchMax + 500)
+	assert.Len(t, out, v1BatchMax, "Batch size should be capped at v1BatchMax")
+}
+
+func TestGenerator_NewV1Batch_CarriesTimeLowOverflow(t *testing.T) {
+	base := Timestamp(0xFFFFFFFF - 5)
+	registerTestGenerator(base, nodeBytes)
+
+	out := generator.NewV1Batch(20)
+	assert.Len(t, out, 20)
+
+	for i, u := range out {
+		want := uint64(base) + uint64(i)
+		assert.Equal(t, want, v1Timestamp(u), "entry %d should carry into time_mid/time_hi once time_low overflows", i)
+	}
+
+	registerDefaultGenerator()
+}
+
+func TestGenerator_NewV4Batch(t *testing.T) {
+	out := generator.NewV4Batch(16)
+
+	assert.Len(t, out, 16)
+
+	seen := map[string]bool{}
+	for _, u := range out {
+		assert.Equal(t, Four, u.Version(), "Expected correct version")
+		assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+		assert.False(t, seen[u.String()], "Batch should not contain duplicates")
+		seen[u.String()] = true
+	}
+}
+
+func BenchmarkGenerator_NewV1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		generator.NewV1()
+	}
+}
+
+func BenchmarkGenerator_NewV1Batch(b *testing.B) {
+	for i := 0; i < b.N; i += v1BatchMax {
+		generator.NewV1Batch(v1BatchMax)
+	}
+}
+
+func BenchmarkGenerator_NewV4(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		generator.NewV4()
+	}
+}
+
+func BenchmarkGenerator_NewV4Batch(b *testing.B) {
+	const n = 1024
+	for i := 0; i < b.N; i += n {
+		generator.NewV4Batch(n)
+	}
+}
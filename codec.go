@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Nil is the zero UUID, with all 128 bits set to zero.
+var Nil = UUID(make([]byte, 16))
+
+// Must is a helper for wrapping calls that return a (UUID, error), such
+// as Parse, in contexts where a failure is unrecoverable, e.g.
+// package-level UUID constants. It panics if err is non-nil.
+func Must(u UUID, err error) UUID {
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// Parse decodes a UUID from any of its common string forms: the
+// canonical hyphenated form, the bare unhyphenated form, a form wrapped
+// in braces ("{...}"), or the URN form ("urn:uuid:...").
+func Parse(s string) (UUID, error) {
+	s = strings.TrimPrefix(s, "urn:uuid:")
+	s = strings.TrimPrefix(s, "{")
+	s = strings.TrimSuffix(s, "}")
+	s = strings.ReplaceAll(s, "-", "")
+
+	if len(s) != 32 {
+		return nil, fmt.Errorf("uuid: invalid UUID length: %q", s)
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("uuid: invalid UUID %q: %w", s, err)
+	}
+
+	return UUID(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical
+// hyphenated string form.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any form
+// Parse does.
+func (u *UUID) UnmarshalText(b []byte) error {
+	parsed, err := Parse(string(b))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, emitting the raw 16
+// bytes of the UUID.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	return []byte(u), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, accepting the
+// raw 16 bytes of a UUID.
+func (u *UUID) UnmarshalBinary(b []byte) error {
+	if len(b) != 16 {
+		return fmt.Errorf("uuid: invalid UUID length: %d bytes", len(b))
+	}
+	cp := make(UUID, 16)
+	copy(cp, b)
+	*u = cp
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting the canonical
+// hyphenated string form.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting any string form
+// Parse does.
+func (u *UUID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,96 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	want := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	forms := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+
+	for _, f := range forms {
+		got, err := Parse(f)
+		assert.NoError(t, err, "Parse(%q) should succeed", f)
+		assert.Equal(t, want, got, "Parse(%q) should decode to the expected UUID", f)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse("not-a-uuid")
+	assert.Error(t, err, "Parse should reject a malformed string")
+}
+
+func TestMust(t *testing.T) {
+	assert.NotPanics(t, func() {
+		Must(Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	})
+	assert.Panics(t, func() {
+		Must(Parse("not-a-uuid"))
+	})
+}
+
+func TestUUID_TextRoundTrip(t *testing.T) {
+	want := NamespaceDNS
+
+	b, err := want.MarshalText()
+	assert.NoError(t, err)
+
+	var got UUID
+	assert.NoError(t, got.UnmarshalText(b))
+	assert.Equal(t, want, got)
+}
+
+func TestUUID_BinaryRoundTrip(t *testing.T) {
+	want := NamespaceDNS
+
+	b, err := want.MarshalBinary()
+	assert.NoError(t, err)
+
+	var got UUID
+	assert.NoError(t, got.UnmarshalBinary(b))
+	assert.Equal(t, want, got)
+}
+
+func TestUUID_JSONRoundTrip(t *testing.T) {
+	want := NamespaceDNS
+
+	b, err := json.Marshal(want)
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+want.String()+`"`, string(b))
+
+	var got UUID
+	assert.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, want, got)
+}
+
+func TestUUID_MarshalJSON_ZeroValue(t *testing.T) {
+	var u UUID
+
+	b, err := json.Marshal(u)
+	assert.NoError(t, err, "marshaling a zero-value UUID should not panic")
+	assert.Equal(t, `"`+Nil.String()+`"`, string(b))
+}
+
+func TestUUID_JSONUnmarshal_AcceptsAllForms(t *testing.T) {
+	forms := []string{
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		`"6ba7b8109dad11d180b400c04fd430c8"`,
+		`"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"`,
+		`"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+	}
+
+	for _, f := range forms {
+		var got UUID
+		assert.NoError(t, json.Unmarshal([]byte(f), &got), "Unmarshal(%s) should succeed", f)
+		assert.Equal(t, NamespaceDNS, got, "Unmarshal(%s) should decode to NamespaceDNS", f)
+	}
+}
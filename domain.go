@@ -0,0 +1,12 @@
+package uuid
+
+// Domain identifies the kind of local identifier embedded in a DCE
+// security (version 2) UUID.
+type Domain byte
+
+// The DCE security domains defined by the DCE 1.1 RPC specification.
+const (
+	DomainUser Domain = iota
+	DomainGroup
+	DomainOrg
+)
@@ -0,0 +1,202 @@
+// Package filesaver provides a file-backed uuid.Saver so that time-based
+// UUIDs stay unique across process restarts.
+package filesaver
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/escribano/uuid"
+)
+
+// fileFormatVersion is bumped whenever the on-disk record layout changes,
+// so Read can reject files written by an incompatible version.
+const fileFormatVersion = 1
+
+// record is the on-disk representation of a uuid.Store. Checksum guards
+// against torn writes: it is computed over the record with Checksum
+// itself zeroed, so any corruption of the other fields is caught by Read.
+type record struct {
+	Version   int            `json:"version"`
+	Timestamp uuid.Timestamp `json:"timestamp"`
+	Sequence  uuid.Sequence  `json:"sequence"`
+	Node      []byte         `json:"node"`
+	Checksum  uint32         `json:"checksum"`
+}
+
+func checksum(r record) uint32 {
+	r.Checksum = 0
+	b, _ := json.Marshal(r)
+	return crc32.ChecksumIEEE(b)
+}
+
+// FileSaver persists a uuid.Store to a file on disk. Save calls are
+// coalesced in memory and flushed at most once per interval (and always
+// on Close), so high-throughput V1 generation doesn't hit the disk on
+// every UUID. Flushes are atomic: the new state is written to path+".tmp",
+// fsynced, then renamed over path, so a crash mid-write can never leave a
+// corrupt file behind.
+type FileSaver struct {
+	path     string
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending *uuid.Store
+	dirty   bool
+
+	closeOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewFileSaver creates a FileSaver that persists to path, flushing
+// buffered Save calls at most once per interval and on Close. An interval
+// of zero or less disables coalescing: every Save is flushed immediately.
+func NewFileSaver(path string, interval time.Duration) *FileSaver {
+	fs := &FileSaver{
+		path:     path,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	if interval > 0 {
+		go fs.flushLoop()
+	} else {
+		close(fs.done)
+	}
+
+	return fs
+}
+
+func (fs *FileSaver) flushLoop() {
+	defer close(fs.done)
+
+	ticker := time.NewTicker(fs.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			fs.flush()
+		case <-fs.stop:
+			return
+		}
+	}
+}
+
+// Save buffers store to be written out on the next tick, or immediately
+// if coalescing is disabled. It satisfies uuid.Saver.
+func (fs *FileSaver) Save(store uuid.Store) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.pending = &store
+	fs.dirty = true
+
+	if fs.interval <= 0 {
+		fs.writeLocked()
+	}
+}
+
+// Read loads the most recently flushed Store. It satisfies uuid.Saver. A
+// missing, truncated, or checksum-mismatched file is returned as an
+// error, so uuid.RegisterSaver falls back to a fresh random sequence
+// rather than trusting corrupt state.
+func (fs *FileSaver) Read() (error, uuid.Store) {
+	b, err := os.ReadFile(fs.path)
+	if err != nil {
+		return err, uuid.Store{}
+	}
+
+	var r record
+	if err := json.Unmarshal(b, &r); err != nil {
+		return fmt.Errorf("filesaver: corrupt file: %w", err), uuid.Store{}
+	}
+
+	if r.Version != fileFormatVersion {
+		return fmt.Errorf("filesaver: unsupported file version %d", r.Version), uuid.Store{}
+	}
+
+	if want := r.Checksum; checksum(r) != want {
+		return fmt.Errorf("filesaver: checksum mismatch, file is corrupt"), uuid.Store{}
+	}
+
+	return nil, uuid.Store{
+		Timestamp: r.Timestamp,
+		Sequence:  r.Sequence,
+		Node:      uuid.Node(r.Node),
+	}
+}
+
+// Close flushes any buffered Store to disk and stops the coalescing
+// ticker.
+func (fs *FileSaver) Close() error {
+	fs.closeOnce.Do(func() {
+		close(fs.stop)
+	})
+	<-fs.done
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.writeLocked()
+}
+
+func (fs *FileSaver) flush() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.writeLocked()
+}
+
+// writeLocked atomically persists the pending Store. The caller must hold
+// fs.mu.
+func (fs *FileSaver) writeLocked() error {
+	if !fs.dirty || fs.pending == nil {
+		return nil
+	}
+
+	r := record{
+		Version:   fileFormatVersion,
+		Timestamp: fs.pending.Timestamp,
+		Sequence:  fs.pending.Sequence,
+		Node:      []byte(fs.pending.Node),
+	}
+	r.Checksum = checksum(r)
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	tmp := fs.path + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, fs.path); err != nil {
+		return err
+	}
+
+	fs.dirty = false
+	return nil
+}
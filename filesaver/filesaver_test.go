@@ -0,0 +1,84 @@
+package filesaver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/escribano/uuid"
+)
+
+func TestFileSaver_SaveAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+
+	fs := NewFileSaver(path, 0)
+	defer fs.Close()
+
+	store := uuid.Store{Timestamp: 123456, Sequence: 7, Node: uuid.Node{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}}
+	fs.Save(store)
+
+	err, got := fs.Read()
+	assert.NoError(t, err, "Read should succeed right after a Save")
+	assert.Equal(t, store, got, "Read should return exactly what was saved")
+}
+
+func TestFileSaver_ReadMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing")
+
+	fs := NewFileSaver(path, 0)
+	defer fs.Close()
+
+	err, _ := fs.Read()
+	assert.Error(t, err, "Read should fail when no file has been written yet")
+}
+
+func TestFileSaver_ReadCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+
+	fs := NewFileSaver(path, 0)
+	defer fs.Close()
+
+	err, _ := fs.Read()
+	assert.Error(t, err, "Read should reject a file that isn't a valid record")
+}
+
+func TestFileSaver_ReadChecksumMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+
+	fs := NewFileSaver(path, 0)
+	fs.Save(uuid.Store{Timestamp: 1, Sequence: 1, Node: uuid.Node{0x01}})
+	assert.NoError(t, fs.Close())
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	b[len(b)-2] ^= 0x01 // change the checksum's last digit to another digit, keeping the JSON valid
+	assert.NoError(t, os.WriteFile(path, b, 0600))
+
+	fs2 := NewFileSaver(path, 0)
+	defer fs2.Close()
+
+	rerr, _ := fs2.Read()
+	assert.Error(t, rerr, "Read should reject a torn/corrupt checksum")
+}
+
+func TestFileSaver_CoalescesWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+
+	fs := NewFileSaver(path, time.Hour)
+	defer fs.Close()
+
+	fs.Save(uuid.Store{Timestamp: 1, Sequence: 1, Node: uuid.Node{0x01}})
+
+	_, err := os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "Save should not hit disk before the coalescing interval or Close")
+
+	assert.NoError(t, fs.Close())
+
+	_, err = os.Stat(path)
+	assert.NoError(t, err, "Close should flush the buffered Store")
+}
@@ -0,0 +1,281 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"os"
+	"sync"
+)
+
+// RandomFunc fills b with random bytes, reporting how many were written,
+// mirroring the signature of crypto/rand.Read.
+type RandomFunc func(b []byte) (int, error)
+
+// Saver persists a Generator's Store so that time-based UUIDs remain
+// unique across process restarts, and restores it on start-up.
+type Saver interface {
+	Save(Store)
+	Read() (error, Store)
+}
+
+// Generator produces UUIDs and keeps the clock state (Store) that the
+// time-based versions need to stay unique.
+type Generator struct {
+	Store
+
+	// Random supplies random bytes for sequence numbers and random UUIDs.
+	Random RandomFunc
+
+	// Saver, when set, persists the Store across restarts.
+	Saver Saver
+
+	err error
+
+	nowFunc  func() Timestamp
+	nodeFunc func() Node
+
+	// v7Milli and v7Counter track the Unix millisecond and intra-millisecond
+	// monotonic counter used by NewV7. They are independent of Store, which
+	// only exists to keep the v1/v6 Gregorian timestamp unique.
+	v7Milli   uint64
+	v7Counter uint16
+
+	sync.Mutex
+}
+
+// generator is the package-level default Generator used by the New*
+// convenience functions.
+var generator *Generator
+
+func init() {
+	registerDefaultGenerator()
+}
+
+func registerDefaultGenerator() {
+	generator = NewGenerator(rand.Read, Now, findFirstHardwareAddress)
+}
+
+// NewGenerator creates a Generator using the given sources of randomness,
+// time, and node id. now and node are taken as functions, rather than
+// fixed values, so tests can control them and so the node id can be
+// re-resolved if it ever changes.
+func NewGenerator(random RandomFunc, now func() Timestamp, node func() Node) *Generator {
+	g := &Generator{
+		Random:   random,
+		nowFunc:  now,
+		nodeFunc: node,
+	}
+	g.read()
+	return g
+}
+
+// Error returns the last error the Generator encountered, if any.
+func (g *Generator) Error() error {
+	g.Lock()
+	defer g.Unlock()
+	return g.err
+}
+
+// randomSequence draws a new clock sequence from Random.
+func (g *Generator) randomSequence() (Sequence, error) {
+	buf := make([]byte, 2)
+	n, err := g.Random(buf)
+	if err != nil || n != len(buf) {
+		return 0, err
+	}
+	return Sequence(binary.BigEndian.Uint16(buf)), nil
+}
+
+// read advances the Generator's clock state ahead of producing a new
+// time-based UUID, then hands the new Store to Saver, if one is
+// registered. See tick for the state transition itself.
+func (g *Generator) read() {
+	g.Lock()
+	defer g.Unlock()
+
+	g.tick()
+	g.save()
+}
+
+// tick applies the RFC 4122 clock sequence rules: if the node hasn't
+// changed and time appears to have moved backwards, the sequence is
+// incremented; if the node has changed, a fresh random sequence is drawn;
+// otherwise the sequence carries over unchanged. The caller must hold
+// g.Lock and is responsible for calling save afterwards.
+func (g *Generator) tick() {
+	newNow := g.nowFunc()
+	newNode := g.nodeFunc()
+
+	if bytes.Equal(g.Node, newNode) {
+		if newNow < g.Timestamp {
+			g.Sequence++
+		}
+	} else if seq, err := g.randomSequence(); err != nil {
+		g.err = err
+	} else {
+		g.Sequence = seq
+	}
+
+	g.Timestamp = newNow
+	g.Node = newNode
+}
+
+// save hands the current Store to the registered Saver, if any.
+func (g *Generator) save() {
+	if g.Saver == nil {
+		return
+	}
+	g.Saver.Save(g.Store)
+}
+
+// RegisterSaver installs s on the default Generator.
+func RegisterSaver(s Saver) {
+	generator.registerSaver(s)
+}
+
+// registerSaver imports s's persisted Store as the Generator's new
+// baseline: the stored sequence is incremented if the stored timestamp is
+// ahead of what the Generator currently believes "now" to be (the clock
+// went backwards since the last save), reused as-is otherwise, or replaced
+// with a fresh random sequence if the stored node doesn't match the
+// current one. If s fails to read back its state, s is left unregistered
+// and the Generator falls back to a freshly randomized sequence.
+func (g *Generator) registerSaver(s Saver) {
+	g.Lock()
+	defer g.Unlock()
+
+	err, stored := s.Read()
+	if err != nil {
+		g.err = err
+		g.Saver = nil
+
+		if seq, rerr := g.randomSequence(); rerr != nil {
+			g.err = rerr
+		} else {
+			g.Sequence = seq
+		}
+
+		g.Timestamp = g.nowFunc()
+		g.Node = g.nodeFunc()
+		return
+	}
+
+	if bytes.Equal(stored.Node, g.Node) {
+		if stored.Timestamp > g.Timestamp {
+			g.Sequence = stored.Sequence + 1
+		} else {
+			g.Sequence = stored.Sequence
+		}
+	} else if seq, rerr := g.randomSequence(); rerr != nil {
+		g.err = rerr
+	} else {
+		g.Sequence = seq
+	}
+
+	g.Timestamp = g.nowFunc()
+	g.Node = g.nodeFunc()
+	g.Saver = s
+}
+
+// fitNode pads or truncates a node id to the 6 bytes a UUID's node field
+// holds.
+func fitNode(n Node) []byte {
+	b := make([]byte, 6)
+	copy(b, n)
+	return b
+}
+
+// localID returns the DCE local identifier embedded in a V2 UUID's
+// time_low field for the given Domain.
+func localID(d Domain) uint32 {
+	switch d {
+	case DomainUser:
+		return uint32(os.Getuid())
+	case DomainGroup:
+		return uint32(os.Getgid())
+	default:
+		return 0
+	}
+}
+
+// NewV1 generates a time-based (version 1) UUID using the Generator's
+// clock Store and node id.
+func (g *Generator) NewV1() UUID {
+	g.read()
+
+	g.Lock()
+	defer g.Unlock()
+
+	if g.err != nil {
+		return nil
+	}
+
+	ts := uint64(g.Timestamp)
+
+	u := make(UUID, 16)
+	binary.BigEndian.PutUint32(u[0:4], uint32(ts))
+	binary.BigEndian.PutUint16(u[4:6], uint16(ts>>32))
+	binary.BigEndian.PutUint16(u[6:8], uint16(ts>>48)&0x0FFF)
+	u[6] |= uint8(One) << 4
+	binary.BigEndian.PutUint16(u[8:10], uint16(g.Sequence))
+	u[8] &= 0x3F
+	u[8] |= 0x80
+	copy(u[10:16], fitNode(g.Node))
+
+	return u
+}
+
+// NewV2 generates a DCE security (version 2) UUID for the given Domain,
+// embedding the domain's local identifier (uid for DomainUser, gid for
+// DomainGroup) in place of the time-based version's time_low field.
+func (g *Generator) NewV2(d Domain) UUID {
+	g.read()
+
+	g.Lock()
+	defer g.Unlock()
+
+	if g.err != nil {
+		return nil
+	}
+
+	ts := uint64(g.Timestamp)
+
+	u := make(UUID, 16)
+	binary.BigEndian.PutUint32(u[0:4], localID(d))
+	binary.BigEndian.PutUint16(u[4:6], uint16(ts>>32))
+	binary.BigEndian.PutUint16(u[6:8], uint16(ts>>48)&0x0FFF)
+	u[6] |= uint8(Two) << 4
+	u[8] = byte(g.Sequence>>8)&0x3F | 0x80
+	u[9] = uint8(d)
+	copy(u[10:16], fitNode(g.Node))
+
+	return u
+}
+
+// NewV4 generates a random (version 4) UUID.
+func (g *Generator) NewV4() UUID {
+	g.Lock()
+	defer g.Unlock()
+
+	u := make(UUID, 16)
+	n, err := g.Random(u)
+	if err != nil || n != len(u) {
+		g.err = err
+		return nil
+	}
+
+	u[6] = (u[6] & 0x0F) | (uint8(Four) << 4)
+	u[8] = (u[8] & 0x3F) | 0x80
+
+	return u
+}
+
+// NewV1 generates a version 1 UUID using the default Generator.
+func NewV1() UUID { return generator.NewV1() }
+
+// NewV2 generates a version 2 UUID using the default Generator.
+func NewV2(d Domain) UUID { return generator.NewV2(d) }
+
+// NewV4 generates a version 4 UUID using the default Generator.
+func NewV4() UUID { return generator.NewV4() }
@@ -259,7 +259,7 @@ func TestGeneratorSave(t *testing.T) {
 
 func TestStore_String(t *testing.T) {
 	store := &Store{Node: []byte{0xdd, 0xee, 0xff, 0xaa, 0xbb}, Sequence: 2, Timestamp: 3}
-	assert.Equal(t, "Timestamp[2167-05-04 23:34:33.709551916 +0000 UTC]-Sequence[2]-Node[ddeeffaabb]", store.String(), "The output store string should match")
+	assert.Equal(t, "Timestamp[1582-10-15 00:00:00.0000003 +0000 UTC]-Sequence[2]-Node[ddeeffaabb]", store.String(), "The output store string should match")
 }
 
 func TestGetHardwareAddress(t *testing.T) {
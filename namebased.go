@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+)
+
+// The name space IDs predefined by RFC 4122 Appendix C, for use as the
+// namespace argument to NewV3 and NewV5.
+var (
+	NamespaceDNS  = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceURL  = UUID{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceOID  = UUID{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceX500 = UUID{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// newNameBased hashes namespace and name together with h and stamps the
+// result with version v, per RFC 4122 section 4.3. Since the result
+// depends only on its inputs, it needs none of the clock/node Store a
+// Generator maintains for the time-based versions.
+func newNameBased(h hashFunc, v Version, namespace UUID, name []byte) UUID {
+	sum := h(append(append([]byte{}, namespace.Bytes()...), name...))
+
+	u := make(UUID, 16)
+	copy(u, sum[:16])
+
+	u[6] = (u[6] & 0x0F) | (uint8(v) << 4)
+	u[8] = (u[8] & 0x3F) | 0x80
+
+	return u
+}
+
+// hashFunc hashes b and returns a digest of at least 16 bytes.
+type hashFunc func(b []byte) []byte
+
+func md5Sum(b []byte) []byte {
+	sum := md5.Sum(b)
+	return sum[:]
+}
+
+func sha1Sum(b []byte) []byte {
+	sum := sha1.Sum(b)
+	return sum[:]
+}
+
+// NewV3 generates a name-based (version 3) UUID by MD5-hashing namespace
+// and name together. The same namespace and name always produce the same
+// UUID.
+func NewV3(namespace UUID, name []byte) UUID {
+	return newNameBased(md5Sum, Three, namespace, name)
+}
+
+// NewV5 generates a name-based (version 5) UUID by SHA-1-hashing
+// namespace and name together. The same namespace and name always produce
+// the same UUID.
+func NewV5(namespace UUID, name []byte) UUID {
+	return newNameBased(sha1Sum, Five, namespace, name)
+}
@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewV3(t *testing.T) {
+	u := NewV3(NamespaceDNS, []byte("widgets.example.com"))
+
+	assert.Equal(t, Three, u.Version(), "Expected correct version")
+	assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+	assert.True(t, parseUUIDRegex.MatchString(u.String()), "Expected string representation to be valid")
+}
+
+func TestNewV3_Deterministic(t *testing.T) {
+	a := NewV3(NamespaceDNS, []byte("widgets.example.com"))
+	b := NewV3(NamespaceDNS, []byte("widgets.example.com"))
+
+	assert.Equal(t, a, b, "Same namespace and name should produce the same UUID")
+}
+
+func TestNewV3_NamespaceIndependence(t *testing.T) {
+	a := NewV3(NamespaceDNS, []byte("widgets.example.com"))
+	b := NewV3(NamespaceURL, []byte("widgets.example.com"))
+
+	assert.NotEqual(t, a, b, "Different namespaces should produce different UUIDs for the same name")
+}
+
+func TestNewV5(t *testing.T) {
+	u := NewV5(NamespaceDNS, []byte("widgets.example.com"))
+
+	assert.Equal(t, Five, u.Version(), "Expected correct version")
+	assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+	assert.True(t, parseUUIDRegex.MatchString(u.String()), "Expected string representation to be valid")
+}
+
+func TestNewV5_Deterministic(t *testing.T) {
+	a := NewV5(NamespaceDNS, []byte("widgets.example.com"))
+	b := NewV5(NamespaceDNS, []byte("widgets.example.com"))
+
+	assert.Equal(t, a, b, "Same namespace and name should produce the same UUID")
+}
+
+func TestNewV5_NamespaceIndependence(t *testing.T) {
+	a := NewV5(NamespaceDNS, []byte("widgets.example.com"))
+	b := NewV5(NamespaceURL, []byte("widgets.example.com"))
+
+	assert.NotEqual(t, a, b, "Different namespaces should produce different UUIDs for the same name")
+}
+
+func TestNewV3AndV5_Independent(t *testing.T) {
+	a := NewV3(NamespaceDNS, []byte("widgets.example.com"))
+	b := NewV5(NamespaceDNS, []byte("widgets.example.com"))
+
+	assert.NotEqual(t, a, b, "V3 and V5 should not collide for the same namespace and name")
+}
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements database/sql/driver.Valuer, storing the UUID as its
+// canonical string form. A nil UUID is stored as SQL NULL. Any other
+// length is rejected rather than silently persisted as Nil's string
+// form, which String's panic-safe fallback would otherwise produce.
+func (u UUID) Value() (driver.Value, error) {
+	if u == nil {
+		return nil, nil
+	}
+	if len(u) != 16 {
+		return nil, fmt.Errorf("uuid: invalid UUID length: %d bytes", len(u))
+	}
+	return u.String(), nil
+}
+
+// Scan implements database/sql.Scanner, accepting the 16-byte binary form
+// (as returned for a Postgres uuid column), the canonical string form, or
+// SQL NULL.
+func (u *UUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*u = nil
+		return nil
+	case []byte:
+		if len(v) == 16 {
+			return u.UnmarshalBinary(v)
+		}
+		return u.UnmarshalText(v)
+	case string:
+		return u.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("uuid: cannot scan %T into UUID", src)
+	}
+}
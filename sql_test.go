@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubDriver is a minimal database/sql/driver.Driver that always returns a
+// single fixed row, used to exercise UUID's Scan against both the forms a
+// real driver might hand back.
+type stubDriver struct{ row driver.Value }
+
+func (d stubDriver) Open(name string) (driver.Conn, error) { return stubConn{row: d.row}, nil }
+
+type stubConn struct{ row driver.Value }
+
+func (c stubConn) Prepare(query string) (driver.Stmt, error) { return stubStmt{row: c.row}, nil }
+func (c stubConn) Close() error                              { return nil }
+func (c stubConn) Begin() (driver.Tx, error)                  { return nil, errors.New("not supported") }
+
+type stubStmt struct{ row driver.Value }
+
+func (s stubStmt) Close() error  { return nil }
+func (s stubStmt) NumInput() int { return -1 }
+func (s stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &stubRows{row: s.row}, nil
+}
+
+type stubRows struct {
+	row  driver.Value
+	done bool
+}
+
+func (r *stubRows) Columns() []string { return []string{"id"} }
+func (r *stubRows) Close() error      { return nil }
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	dest[0] = r.row
+	r.done = true
+	return nil
+}
+
+func TestUUID_SQLScan_String(t *testing.T) {
+	sql.Register("uuidstub-string", stubDriver{row: NamespaceDNS.String()})
+	db, err := sql.Open("uuidstub-string", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	var got UUID
+	assert.NoError(t, db.QueryRow("select id").Scan(&got))
+	assert.Equal(t, NamespaceDNS, got, "Scan should decode the canonical string form")
+}
+
+func TestUUID_SQLScan_Binary(t *testing.T) {
+	sql.Register("uuidstub-binary", stubDriver{row: NamespaceDNS.Bytes()})
+	db, err := sql.Open("uuidstub-binary", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	var got UUID
+	assert.NoError(t, db.QueryRow("select id").Scan(&got))
+	assert.Equal(t, NamespaceDNS, got, "Scan should decode the 16-byte binary form")
+}
+
+func TestUUID_SQLValue(t *testing.T) {
+	v, err := NamespaceDNS.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, NamespaceDNS.String(), v)
+}
+
+func TestUUID_SQLValue_Nil(t *testing.T) {
+	var u UUID
+	v, err := u.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestUUID_SQLValue_Malformed(t *testing.T) {
+	u := UUID{0x01, 0x02, 0x03}
+	v, err := u.Value()
+	assert.Error(t, err, "a malformed UUID must not be silently persisted as Nil's string form")
+	assert.Nil(t, v)
+}
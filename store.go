@@ -0,0 +1,81 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net"
+	"time"
+)
+
+// gregorianToUnix is the number of 100ns intervals between the start of the
+// Gregorian calendar (1582-10-15, the UUID timestamp epoch) and the Unix
+// epoch (1970-01-01).
+const gregorianToUnix = 122192928000000000
+
+// Timestamp is a count of 100-nanosecond intervals since the UUID epoch
+// (1582-10-15 00:00:00 UTC), as used by the time-based UUID versions.
+type Timestamp uint64
+
+// Now returns the current time as a UUID Timestamp.
+func Now() Timestamp {
+	return Timestamp(uint64(time.Now().UnixNano()/100) + gregorianToUnix)
+}
+
+// Time converts a Timestamp back to a standard Go time.
+func (t Timestamp) Time() time.Time {
+	secs := int64(t/1e7) - gregorianToUnix/1e7
+	nsecs := int64(t%1e7) * 100
+	return time.Unix(secs, nsecs).UTC()
+}
+
+// Add returns t plus d.
+func (t Timestamp) Add(d time.Duration) Timestamp {
+	return t + Timestamp(d/100)
+}
+
+// Sub returns t minus d.
+func (t Timestamp) Sub(d time.Duration) Timestamp {
+	return t - Timestamp(d/100)
+}
+
+// Sequence is the clock sequence used to detect clock regressions and to
+// distinguish UUIDs generated within the same timestamp tick.
+type Sequence uint16
+
+// Node is the spatially unique node identifier, usually an IEEE 802 MAC
+// address, embedded in time-based UUIDs.
+type Node []byte
+
+// Store is the portion of a Generator's state that needs to survive a
+// process restart so that time-based UUIDs it creates remain unique.
+type Store struct {
+	Timestamp Timestamp
+	Sequence  Sequence
+	Node      Node
+}
+
+// String renders the Store in a human-readable form for logging.
+func (s Store) String() string {
+	return fmt.Sprintf("Timestamp[%s]-Sequence[%d]-Node[%x]", s.Timestamp.Time(), s.Sequence, []byte(s.Node))
+}
+
+// findFirstHardwareAddress returns the hardware address of the first
+// network interface that has one. If no interface exposes a hardware
+// address, it falls back to a random node id with the multicast bit set,
+// as recommended by RFC 4122 so it can never collide with a real MAC
+// address.
+func findFirstHardwareAddress() Node {
+	if ifaces, err := net.Interfaces(); err == nil {
+		for _, iface := range ifaces {
+			if len(iface.HardwareAddr) != 0 {
+				return Node(iface.HardwareAddr)
+			}
+		}
+	}
+
+	b := make([]byte, 6)
+	rand.Read(b)
+	b[0] |= 0x01
+
+	return Node(b)
+}
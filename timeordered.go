@@ -0,0 +1,88 @@
+package uuid
+
+import "encoding/binary"
+
+// NewV6 generates a time-ordered (version 6) UUID: a field-compatible
+// reordering of version 1 that puts the most significant bits of the
+// Gregorian timestamp first, so UUIDs sort lexically by creation time. It
+// reuses the same clock Store, Sequence, and node handling as NewV1.
+func (g *Generator) NewV6() UUID {
+	g.read()
+
+	g.Lock()
+	defer g.Unlock()
+
+	if g.err != nil {
+		return nil
+	}
+
+	ts := uint64(g.Timestamp)
+
+	u := make(UUID, 16)
+	binary.BigEndian.PutUint32(u[0:4], uint32(ts>>28))
+	binary.BigEndian.PutUint16(u[4:6], uint16(ts>>12))
+	binary.BigEndian.PutUint16(u[6:8], uint16(ts&0x0FFF))
+	u[6] |= uint8(Six) << 4
+	binary.BigEndian.PutUint16(u[8:10], uint16(g.Sequence))
+	u[8] &= 0x3F
+	u[8] |= 0x80
+	copy(u[10:16], fitNode(g.Node))
+
+	return u
+}
+
+// v7CounterMax is the largest value the 12-bit rand_a monotonic counter
+// can hold before a V7 UUID must advance to the next millisecond.
+const v7CounterMax = 0x0FFF
+
+// NewV7 generates a time-ordered (version 7) UUID: a Unix millisecond
+// timestamp in the first 48 bits, a 12-bit counter in rand_a that is reset
+// on every new millisecond and incremented within it (advancing the
+// millisecond itself if the counter saturates), and 62 bits of randomness
+// in rand_b.
+func (g *Generator) NewV7() UUID {
+	g.Lock()
+	defer g.Unlock()
+
+	milli := (uint64(g.nowFunc()) - gregorianToUnix) / 10000
+
+	switch {
+	case milli > g.v7Milli:
+		g.v7Milli = milli
+		g.v7Counter = 0
+	case g.v7Counter < v7CounterMax:
+		g.v7Counter++
+	default:
+		g.v7Milli++
+		g.v7Counter = 0
+	}
+
+	randB := make([]byte, 8)
+	n, err := g.Random(randB)
+	if err != nil || n != len(randB) {
+		g.err = err
+		return nil
+	}
+
+	u := make(UUID, 16)
+	u[0] = byte(g.v7Milli >> 40)
+	u[1] = byte(g.v7Milli >> 32)
+	u[2] = byte(g.v7Milli >> 24)
+	u[3] = byte(g.v7Milli >> 16)
+	u[4] = byte(g.v7Milli >> 8)
+	u[5] = byte(g.v7Milli)
+
+	u[6] = uint8(Seven)<<4 | byte(g.v7Counter>>8&0x0F)
+	u[7] = byte(g.v7Counter)
+
+	copy(u[8:16], randB)
+	u[8] = (u[8] & 0x3F) | 0x80
+
+	return u
+}
+
+// NewV6 generates a version 6 UUID using the default Generator.
+func NewV6() UUID { return generator.NewV6() }
+
+// NewV7 generates a version 7 UUID using the default Generator.
+func NewV7() UUID { return generator.NewV7() }
@@ -0,0 +1,73 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerator_V6(t *testing.T) {
+	u := generator.NewV6()
+
+	assert.Equal(t, Six, u.Version(), "Expected correct version")
+	assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+	assert.True(t, parseUUIDRegex.MatchString(u.String()), "Expected string representation to be valid")
+}
+
+func TestGenerator_V6_Sortable(t *testing.T) {
+	now := Now()
+	i := 0
+
+	timestamps := []Timestamp{now, now + 1, now + 2}
+
+	generator = NewGenerator(
+		generator.Random,
+		func() Timestamp { return timestamps[i] },
+		func() Node { return nodeBytes })
+
+	var prev UUID
+	for ; i < len(timestamps); i++ {
+		u := generator.NewV6()
+		if prev != nil {
+			assert.True(t, prev.String() < u.String(), "V6 UUIDs should sort lexically by creation time")
+		}
+		prev = u
+	}
+
+	registerDefaultGenerator()
+}
+
+func TestGenerator_V7(t *testing.T) {
+	u := generator.NewV7()
+
+	assert.Equal(t, Seven, u.Version(), "Expected correct version")
+	assert.Equal(t, VariantRFC4122, u.Variant(), "Expected correct variant")
+	assert.True(t, parseUUIDRegex.MatchString(u.String()), "Expected string representation to be valid")
+}
+
+func TestGenerator_V7_CounterAdvancesWithinMillisecond(t *testing.T) {
+	registerTestGenerator(Now(), nodeBytes)
+
+	a := generator.NewV7()
+	b := generator.NewV7()
+
+	assert.NotEqual(t, a, b, "Two V7 UUIDs minted within the same millisecond must still differ")
+	assert.True(t, a.String() < b.String(), "The counter should make same-millisecond V7 UUIDs sort in mint order")
+
+	registerDefaultGenerator()
+}
+
+func TestGenerator_V7_CounterSaturates(t *testing.T) {
+	registerTestGenerator(Now(), nodeBytes)
+
+	generator.NewV7() // establish v7Milli for this fixed "now"
+	generator.v7Counter = v7CounterMax
+
+	before := generator.v7Milli
+	generator.NewV7()
+
+	assert.Equal(t, before+1, generator.v7Milli, "Counter overflow should advance the millisecond")
+	assert.Equal(t, uint16(0), generator.v7Counter, "Counter should reset after overflow")
+
+	registerDefaultGenerator()
+}
@@ -0,0 +1,82 @@
+// Package uuid implements RFC 4122 UUIDs: parsing, formatting, and
+// generators for the time-based, DCE security, name-based, time-ordered,
+// and random UUID versions.
+package uuid
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// UUID is a 128-bit universally unique identifier as defined by RFC 4122.
+// It is backed by a byte slice rather than a fixed-size array so that a
+// failed generation can be reported as a nil UUID.
+type UUID []byte
+
+// Version identifies the RFC 4122 sub-type of a UUID (time-based, DCE
+// security, name-based, random, ...).
+type Version byte
+
+// The UUID versions this package knows how to generate.
+const (
+	_ Version = iota
+	One
+	Two
+	Three
+	Four
+	Five
+	Six
+	Seven
+)
+
+// Variant identifies the layout of the UUID's variant-and-sequence field.
+type Variant byte
+
+// The UUID variants defined by RFC 4122.
+const (
+	VariantNCS Variant = iota
+	VariantRFC4122
+	VariantMicrosoft
+	VariantFuture
+)
+
+// parseUUIDRegex matches the canonical, hyphenated, lower-case string form
+// of a UUID.
+var parseUUIDRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Bytes returns the raw 16 bytes backing the UUID.
+func (u UUID) Bytes() []byte {
+	return []byte(u)
+}
+
+// Version returns the version encoded in the UUID's time_hi_and_version
+// field.
+func (u UUID) Version() Version {
+	return Version(u[6] >> 4)
+}
+
+// Variant returns the variant encoded in the UUID's
+// clock_seq_hi_and_reserved field.
+func (u UUID) Variant() Variant {
+	switch {
+	case u[8]&0xE0 == 0xE0:
+		return VariantFuture
+	case u[8]&0xE0 == 0xC0:
+		return VariantMicrosoft
+	case u[8]&0xC0 == 0x80:
+		return VariantRFC4122
+	default:
+		return VariantNCS
+	}
+}
+
+// String returns the canonical, hyphenated, lower-case string form of the
+// UUID, e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8". A nil or otherwise
+// malformed UUID renders as Nil's string form rather than panicking.
+func (u UUID) String() string {
+	if len(u) != 16 {
+		u = Nil
+	}
+	b := []byte(u)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}